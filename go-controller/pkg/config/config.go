@@ -145,6 +145,16 @@ type KubernetesConfig struct {
 	OVNConfigNamespace string `gcfg:"ovn-config-namespace"`
 	MetricsBindAddress string `gcfg:"metrics-bind-address"`
 	OVNEmptyLbEvents   bool   `gcfg:"ovn-empty-lb-events"`
+	// APIQPS is the sustained rate of requests per second the Kubernetes
+	// client may make to the apiserver. If zero, the client-go default is used.
+	APIQPS int `gcfg:"api-qps"`
+	// APIBurst is the maximum burst of requests the Kubernetes client may
+	// make to the apiserver. If zero, the client-go default is used.
+	APIBurst int `gcfg:"api-burst"`
+	// NodeResyncInterval is the number of seconds between periodic resyncs
+	// of the node informer. If zero, nodes are resynced along with every
+	// other watched resource.
+	NodeResyncInterval int `gcfg:"node-resync-interval"`
 }
 
 // GatewayMode holds the node gateway mode
@@ -507,6 +517,23 @@ var K8sFlags = []cli.Flag{
 			"will spin up pods for the load balancer to send traffic to.",
 		Destination: &cliConfig.Kubernetes.OVNEmptyLbEvents,
 	},
+	cli.IntFlag{
+		Name:        "k8s-api-qps",
+		Usage:       "sustained requests per second the Kubernetes client may send to the API server (default: client-go default)",
+		Destination: &cliConfig.Kubernetes.APIQPS,
+	},
+	cli.IntFlag{
+		Name:        "k8s-api-burst",
+		Usage:       "maximum burst of requests the Kubernetes client may send to the API server (default: client-go default)",
+		Destination: &cliConfig.Kubernetes.APIBurst,
+	},
+	cli.IntFlag{
+		Name: "node-resync-interval",
+		Usage: "Number of seconds between periodic resyncs of watched Node " +
+			"objects, which re-deliver every node as an update event " +
+			"(default: 0, use the global 12 hour resync interval)",
+		Destination: &cliConfig.Kubernetes.NodeResyncInterval,
+	},
 }
 
 // OvnNBFlags capture OVN northbound database options
@@ -756,6 +783,13 @@ func buildKubernetesConfig(exec kexec.Interface, cli, file *config, saPath strin
 		return fmt.Errorf("kubernetes CA certificate file %q not found", Kubernetes.CACert)
 	}
 
+	if Kubernetes.APIQPS < 0 {
+		return fmt.Errorf("kubernetes API QPS %d must not be negative", Kubernetes.APIQPS)
+	}
+	if Kubernetes.APIBurst < 0 {
+		return fmt.Errorf("kubernetes API burst %d must not be negative", Kubernetes.APIBurst)
+	}
+
 	url, err := url.Parse(Kubernetes.APIServer)
 	if err != nil {
 		return fmt.Errorf("kubernetes API server address %q invalid: %v", Kubernetes.APIServer, err)
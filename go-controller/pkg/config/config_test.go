@@ -143,6 +143,8 @@ apiserver=https://1.2.3.4:6443
 token=TG9yZW0gaXBzdW0gZ
 cacert=/path/to/kubeca.crt
 service-cidr=172.18.0.0/24
+api-qps=25
+api-burst=50
 
 [logging]
 loglevel=5
@@ -241,6 +243,8 @@ var _ = Describe("Config Operations", func() {
 			Expect(Kubernetes.Token).To(Equal(""))
 			Expect(Kubernetes.APIServer).To(Equal("http://localhost:8080"))
 			Expect(Kubernetes.ServiceCIDR).To(Equal("172.16.1.0/24"))
+			Expect(Kubernetes.APIQPS).To(Equal(0))
+			Expect(Kubernetes.APIBurst).To(Equal(0))
 			Expect(Default.ClusterSubnets).To(Equal([]CIDRNetworkEntry{
 				{mustParseCIDR("10.128.0.0/14"), 23},
 			}))
@@ -472,6 +476,8 @@ var _ = Describe("Config Operations", func() {
 			Expect(Kubernetes.Token).To(Equal("TG9yZW0gaXBzdW0gZ"))
 			Expect(Kubernetes.APIServer).To(Equal("https://1.2.3.4:6443"))
 			Expect(Kubernetes.ServiceCIDR).To(Equal("172.18.0.0/24"))
+			Expect(Kubernetes.APIQPS).To(Equal(25))
+			Expect(Kubernetes.APIBurst).To(Equal(50))
 			Expect(Default.ClusterSubnets).To(Equal([]CIDRNetworkEntry{
 				{mustParseCIDR("10.129.0.0/14"), 23},
 			}))
@@ -529,6 +535,8 @@ var _ = Describe("Config Operations", func() {
 			Expect(Kubernetes.Token).To(Equal("asdfasdfasdfasfd"))
 			Expect(Kubernetes.APIServer).To(Equal("https://4.4.3.2:8080"))
 			Expect(Kubernetes.ServiceCIDR).To(Equal("172.15.0.0/24"))
+			Expect(Kubernetes.APIQPS).To(Equal(100))
+			Expect(Kubernetes.APIBurst).To(Equal(200))
 			Expect(Default.ClusterSubnets).To(Equal([]CIDRNetworkEntry{
 				{mustParseCIDR("10.130.0.0/15"), 24},
 			}))
@@ -564,6 +572,8 @@ var _ = Describe("Config Operations", func() {
 			"-k8s-cacert=" + kubeCAFile,
 			"-k8s-token=asdfasdfasdfasfd",
 			"-k8s-service-cidr=172.15.0.0/24",
+			"-k8s-api-qps=100",
+			"-k8s-api-burst=200",
 			"-nb-address=ssl://6.5.4.3:6651",
 			"-nb-client-privkey=/client/privkey",
 			"-nb-client-cert=/client/cert",
@@ -641,6 +651,20 @@ cluster-subnets=172.18.0.0/24
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("returns an error when the k8s-api-qps is negative", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			Expect(err).To(MatchError("kubernetes API QPS -1 must not be negative"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-k8s-api-qps=-1",
+		}
+		err := app.Run(cliArgs)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
 	It("returns an error when the cluster-subnets is invalid", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
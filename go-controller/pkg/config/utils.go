@@ -44,6 +44,18 @@ func ParseClusterSubnetEntries(clusterSubnetCmd string) ([]CIDRNetworkEntry, err
 			return nil, err
 		}
 
+		// the host subnet must fit inside the CIDR and must leave room for the
+		// network, router, management port and broadcast addresses
+		entryMaskLength, addrLength := parsedClusterEntry.CIDR.Mask.Size()
+		if parsedClusterEntry.HostSubnetLength < uint32(entryMaskLength) {
+			return nil, fmt.Errorf("CIDR %q has host subnet length %d shorter than its prefix length %d",
+				clusterEntry, parsedClusterEntry.HostSubnetLength, entryMaskLength)
+		}
+		if parsedClusterEntry.HostSubnetLength > uint32(addrLength-2) {
+			return nil, fmt.Errorf("CIDR %q has host subnet length %d, must be at most %d",
+				clusterEntry, parsedClusterEntry.HostSubnetLength, addrLength-2)
+		}
+
 		//check to make sure that no cidrs overlap
 		if cidrsOverlap(parsedClusterEntry.CIDR, parsedClusterList) {
 			return nil, fmt.Errorf("CIDR %q overlaps with another cluster network CIDR", clusterEntry)
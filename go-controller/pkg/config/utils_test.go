@@ -56,6 +56,48 @@ func TestParseClusterSubnetEntries(t *testing.T) {
 			clusterNetworks: nil,
 			expectedErr:     true,
 		},
+		{
+			name:            "HostSubnetLength equal to the CIDR prefix length",
+			cmdLineArg:      "10.132.0.0/26/26",
+			clusterNetworks: []CIDRNetworkEntry{{CIDR: returnIPNetPointers("10.132.0.0/26"), HostSubnetLength: 26}},
+			expectedErr:     false,
+		},
+		{
+			name:            "HostSubnetLength shorter than the CIDR prefix length",
+			cmdLineArg:      "10.132.0.0/26/25",
+			clusterNetworks: nil,
+			expectedErr:     true,
+		},
+		{
+			name:            "HostSubnetLength at the IPv4 maximum",
+			cmdLineArg:      "10.132.0.0/26/30",
+			clusterNetworks: []CIDRNetworkEntry{{CIDR: returnIPNetPointers("10.132.0.0/26"), HostSubnetLength: 30}},
+			expectedErr:     false,
+		},
+		{
+			name:            "HostSubnetLength too long for IPv4",
+			cmdLineArg:      "10.132.0.0/26/31",
+			clusterNetworks: nil,
+			expectedErr:     true,
+		},
+		{
+			name:            "HostSubnetLength beyond the address length",
+			cmdLineArg:      "10.132.0.0/26/40",
+			clusterNetworks: nil,
+			expectedErr:     true,
+		},
+		{
+			name:            "HostSubnetLength at the IPv6 maximum",
+			cmdLineArg:      "fd00:10:132::/64/126",
+			clusterNetworks: []CIDRNetworkEntry{{CIDR: returnIPNetPointers("fd00:10:132::/64"), HostSubnetLength: 126}},
+			expectedErr:     false,
+		},
+		{
+			name:            "HostSubnetLength too long for IPv6",
+			cmdLineArg:      "fd00:10:132::/64/127",
+			clusterNetworks: nil,
+			expectedErr:     true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -64,6 +106,9 @@ func TestParseClusterSubnetEntries(t *testing.T) {
 		if err != nil && !tc.expectedErr {
 			t.Errorf("Test case \"%s\" expected no errors, got %v", tc.name, err)
 		}
+		if err == nil && tc.expectedErr {
+			t.Errorf("Test case \"%s\" expected an error, got none", tc.name)
+		}
 		if len(tc.clusterNetworks) != len(parsedList) {
 			t.Errorf("Test case \"%s\" expected to output the same number of entries as parseClusterSubnetEntries", tc.name)
 		} else {
@@ -16,6 +16,8 @@ import (
 	informerfactory "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 )
 
 // Handler represents an event handler and is private to the factory module
@@ -97,8 +99,16 @@ func NewWatchFactory(c kubernetes.Interface, stopChan chan struct{}) (*WatchFact
 	// events on pods and assuming that an 'ADD' event will contain the annotations put in by
 	// ovnkube master (currently, it is just a 'get' loop)
 	// the downside of making it tight (like 10 minutes) is needless spinning on all resources
+	// Nodes may be given their own, tighter, resync interval so that controllers
+	// which reconcile node state get a periodic chance to repair missed events
+	var options []informerfactory.SharedInformerOption
+	if config.Kubernetes.NodeResyncInterval > 0 {
+		options = append(options, informerfactory.WithCustomResyncConfig(map[metav1.Object]time.Duration{
+			&kapi.Node{}: time.Duration(config.Kubernetes.NodeResyncInterval) * time.Second,
+		}))
+	}
 	wf := &WatchFactory{
-		iFactory:  informerfactory.NewSharedInformerFactory(c, resyncInterval),
+		iFactory:  informerfactory.NewSharedInformerFactoryWithOptions(c, resyncInterval, options...),
 		informers: make(map[reflect.Type]*informer),
 		stopChan:  stopChan,
 	}
@@ -372,6 +382,11 @@ func (wf *WatchFactory) AddNodeHandler(handlerFuncs cache.ResourceEventHandler,
 	return wf.addHandler(nodeType, "", nil, handlerFuncs, processExisting)
 }
 
+// AddFilteredNodeHandler adds a handler function that will be executed when Node objects that match the given label selector change
+func (wf *WatchFactory) AddFilteredNodeHandler(lsel *metav1.LabelSelector, handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{})) (*Handler, error) {
+	return wf.addHandler(nodeType, "", lsel, handlerFuncs, processExisting)
+}
+
 // RemoveNodeHandler removes a Node object event handler function
 func (wf *WatchFactory) RemoveNodeHandler(handler *Handler) error {
 	return wf.removeHandler(nodeType, handler)
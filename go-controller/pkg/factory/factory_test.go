@@ -14,6 +14,8 @@ import (
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -422,6 +424,66 @@ var _ = Describe("Watch Factory Operations", func() {
 		wf.Shutdown()
 	})
 
+	It("resyncs nodes at the configured node resync interval", func() {
+		config.Kubernetes.NodeResyncInterval = 1
+		defer func() {
+			config.Kubernetes.NodeResyncInterval = 0
+		}()
+
+		wf, err := NewWatchFactory(fakeClient, stop)
+		Expect(err).NotTo(HaveOccurred())
+
+		added := newNode("mynode")
+		h := addHandler(wf, nodeType, cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				node := obj.(*v1.Node)
+				Expect(reflect.DeepEqual(node, added)).To(BeTrue())
+			},
+			UpdateFunc: func(old, new interface{}) {
+				newNode := new.(*v1.Node)
+				Expect(reflect.DeepEqual(newNode, added)).To(BeTrue())
+			},
+			DeleteFunc: func(obj interface{}) {},
+		})
+
+		nodes = append(nodes, added)
+		nodeWatch.Add(added)
+		Eventually(func() int { return numAdded }, 2).Should(Equal(1))
+		// No Modify is sent; the update comes from the informer resync
+		Eventually(func() int { return numUpdated }, 5).Should(BeNumerically(">=", 1))
+
+		wf.removeHandler(nodeType, h)
+		wf.Shutdown()
+	})
+
+	It("unwraps node tombstones before calling DELETE", func() {
+		wf, err := NewWatchFactory(fakeClient, stop)
+		Expect(err).NotTo(HaveOccurred())
+
+		deleted := newNode("mynode")
+		h := addHandler(wf, nodeType, cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) {},
+			UpdateFunc: func(old, new interface{}) {},
+			DeleteFunc: func(obj interface{}) {
+				node := obj.(*v1.Node)
+				Expect(reflect.DeepEqual(node, deleted)).To(BeTrue())
+			},
+		})
+
+		// The informer hands us a tombstone when it missed the final
+		// state of a deleted object; the handler must see the Node
+		fedHandler := wf.newFederatedHandler(wf.informers[nodeType])
+		fedHandler.OnDelete(cache.DeletedFinalStateUnknown{Key: deleted.Name, Obj: deleted})
+		Expect(numDeleted).To(Equal(1))
+
+		// Tombstones wrapping an object of the wrong type are dropped
+		fedHandler.OnDelete(cache.DeletedFinalStateUnknown{Key: "mypod", Obj: newPod("mypod", "default")})
+		Expect(numDeleted).To(Equal(1))
+
+		wf.removeHandler(nodeType, h)
+		wf.Shutdown()
+	})
+
 	It("responds to policy add/update/delete events", func() {
 		wf, err := NewWatchFactory(fakeClient, stop)
 		Expect(err).NotTo(HaveOccurred())
@@ -631,6 +693,73 @@ var _ = Describe("Watch Factory Operations", func() {
 		wf.Shutdown()
 	})
 
+	It("filters nodes correctly by label", func() {
+		wf, err := NewWatchFactory(fakeClient, stop)
+		Expect(err).NotTo(HaveOccurred())
+
+		passesFilter := newNode("node1")
+		passesFilter.ObjectMeta.Labels["blah"] = "foobar"
+		failsFilter := newNode("node2")
+		failsFilter.ObjectMeta.Labels["blah"] = "baz"
+
+		h, err := wf.AddFilteredNodeHandler(
+			&metav1.LabelSelector{
+				MatchLabels: map[string]string{"blah": "foobar"},
+			},
+			cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					defer GinkgoRecover()
+					numAdded++
+					node := obj.(*v1.Node)
+					Expect(reflect.DeepEqual(node, passesFilter)).To(BeTrue())
+				},
+				UpdateFunc: func(old, new interface{}) {
+					defer GinkgoRecover()
+					numUpdated++
+					newNode := new.(*v1.Node)
+					Expect(reflect.DeepEqual(newNode, passesFilter)).To(BeTrue())
+				},
+				DeleteFunc: func(obj interface{}) {
+					defer GinkgoRecover()
+					numDeleted++
+					node := obj.(*v1.Node)
+					Expect(reflect.DeepEqual(node, passesFilter)).To(BeTrue())
+				},
+			}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h).NotTo(BeNil())
+
+		nodes = append(nodes, passesFilter)
+		nodeWatch.Add(passesFilter)
+		Eventually(func() int { return numAdded }, 2).Should(Equal(1))
+
+		// numAdded should remain 1
+		nodes = append(nodes, failsFilter)
+		nodeWatch.Add(failsFilter)
+		Consistently(func() int { return numAdded }, 2).Should(Equal(1))
+
+		passesFilter.Status.Phase = v1.NodeTerminated
+		nodeWatch.Modify(passesFilter)
+		Eventually(func() int { return numUpdated }, 2).Should(Equal(1))
+
+		// numUpdated should remain 1
+		failsFilter.Status.Phase = v1.NodeTerminated
+		nodeWatch.Modify(failsFilter)
+		Consistently(func() int { return numUpdated }, 2).Should(Equal(1))
+
+		nodes = []*v1.Node{failsFilter}
+		nodeWatch.Delete(passesFilter)
+		Eventually(func() int { return numDeleted }, 2).Should(Equal(1))
+
+		// numDeleted should remain 1
+		nodes = nodes[:0]
+		nodeWatch.Delete(failsFilter)
+		Consistently(func() int { return numDeleted }, 2).Should(Equal(1))
+
+		wf.RemoveNodeHandler(h)
+		wf.Shutdown()
+	})
+
 	It("correctly handles object updates that cause filter changes", func() {
 		wf, err := NewWatchFactory(fakeClient, stop)
 		Expect(err).NotTo(HaveOccurred())
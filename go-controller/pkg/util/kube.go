@@ -16,6 +16,16 @@ import (
 // NewClientset creates a Kubernetes clientset from either a kubeconfig,
 // TLS properties, or an apiserver URL
 func NewClientset(conf *config.KubernetesConfig) (*kubernetes.Clientset, error) {
+	kconfig, err := newRestConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(kconfig)
+}
+
+// newRestConfig builds the client configuration used by NewClientset,
+// including any client-side rate limits
+func newRestConfig(conf *config.KubernetesConfig) (*rest.Config, error) {
 	var kconfig *rest.Config
 	var err error
 
@@ -48,7 +58,13 @@ func NewClientset(conf *config.KubernetesConfig) (*kubernetes.Clientset, error)
 		return nil, err
 	}
 
-	return kubernetes.NewForConfig(kconfig)
+	if conf.APIQPS > 0 {
+		kconfig.QPS = float32(conf.APIQPS)
+	}
+	if conf.APIBurst > 0 {
+		kconfig.Burst = conf.APIBurst
+	}
+	return kconfig, nil
 }
 
 // IsClusterIPSet checks if the service is an headless service or not
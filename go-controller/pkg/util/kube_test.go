@@ -0,0 +1,31 @@
+package util
+
+import (
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kube Operations", func() {
+	It("uses the client-go rate limits by default", func() {
+		kconfig, err := newRestConfig(&config.KubernetesConfig{
+			APIServer: "http://1.2.3.4:8080",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kconfig.QPS).To(Equal(float32(0)))
+		Expect(kconfig.Burst).To(Equal(0))
+	})
+
+	It("applies the configured QPS and burst", func() {
+		kconfig, err := newRestConfig(&config.KubernetesConfig{
+			APIServer: "http://1.2.3.4:8080",
+			APIQPS:    50,
+			APIBurst:  100,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kconfig.Host).To(Equal("http://1.2.3.4:8080"))
+		Expect(kconfig.QPS).To(Equal(float32(50)))
+		Expect(kconfig.Burst).To(Equal(100))
+	})
+})
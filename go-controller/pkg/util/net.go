@@ -21,7 +21,11 @@ func GenerateMac() string {
 // NextIP returns IP incremented by 1
 func NextIP(ip net.IP) net.IP {
 	i := ipToInt(ip)
-	return intToIP(i.Add(i, big.NewInt(1)))
+	ipLen := net.IPv6len
+	if ip.To4() != nil {
+		ipLen = net.IPv4len
+	}
+	return intToIP(i.Add(i, big.NewInt(1)), ipLen)
 }
 
 func ipToInt(ip net.IP) *big.Int {
@@ -31,8 +35,16 @@ func ipToInt(ip net.IP) *big.Int {
 	return big.NewInt(0).SetBytes(ip.To16())
 }
 
-func intToIP(i *big.Int) net.IP {
-	return net.IP(i.Bytes())
+// intToIP converts i to an IP of ipLen bytes. big.Int drops leading zero
+// bytes, so they are added back; any carry out of the top byte is dropped.
+func intToIP(i *big.Int, ipLen int) net.IP {
+	intBytes := i.Bytes()
+	if len(intBytes) > ipLen {
+		intBytes = intBytes[len(intBytes)-ipLen:]
+	}
+	ip := make(net.IP, ipLen)
+	copy(ip[ipLen-len(intBytes):], intBytes)
+	return ip
 }
 
 // GetPortAddresses returns the MAC and IP of the given logical switch port
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Net Operations", func() {
+	It("increments IPv4 and IPv6 addresses with carry", func() {
+		tests := []struct {
+			ip       string
+			expected string
+		}{
+			{"10.1.2.3", "10.1.2.4"},
+			{"10.1.2.255", "10.1.3.0"},
+			{"10.255.255.255", "11.0.0.0"},
+			{"0.0.0.1", "0.0.0.2"},
+			{"fd00:10:244:1::", "fd00:10:244:1::1"},
+			{"fd00:10:244:1::ffff", "fd00:10:244:1::1:0"},
+			{"fd00:10:244:1:ffff:ffff:ffff:ffff", "fd00:10:244:2::"},
+			{"::1", "::2"},
+		}
+
+		for _, tc := range tests {
+			ip := net.ParseIP(tc.ip)
+			Expect(ip).NotTo(BeNil())
+			next := NextIP(ip)
+			Expect(next.String()).To(Equal(tc.expected), "incrementing %s", tc.ip)
+			if ip.To4() != nil {
+				Expect(len(next)).To(Equal(net.IPv4len))
+			} else {
+				Expect(len(next)).To(Equal(net.IPv6len))
+			}
+		}
+	})
+
+	It("returns the third address of an IPv6 /64 subnet", func() {
+		_, subnet, err := net.ParseCIDR("fd00:10:244:1::/64")
+		Expect(err).NotTo(HaveOccurred())
+		ip := NextIP(NextIP(NextIP(subnet.IP)))
+		Expect(ip.String()).To(Equal("fd00:10:244:1::3"))
+		Expect(subnet.Contains(ip)).To(BeTrue())
+	})
+
+	It("returns IPv6 well-known node addresses", func() {
+		_, subnet, err := net.ParseCIDR("fd00:10:244:1::/64")
+		Expect(err).NotTo(HaveOccurred())
+		routerIP, mgmtIP := GetNodeWellKnownAddresses(subnet)
+		Expect(routerIP.String()).To(Equal("fd00:10:244:1::1/64"))
+		Expect(mgmtIP.String()).To(Equal("fd00:10:244:1::2/64"))
+	})
+})